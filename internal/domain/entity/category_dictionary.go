@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// DepreciationPolicy はカテゴリごとの定額法による減価償却の設定
+// current_value = purchase_price * max(MinResidualRate, 1 - AnnualRate * 経過年数)
+type DepreciationPolicy struct {
+	// AnnualRate は1年あたりの減価率 (0.0〜1.0)
+	AnnualRate float64 `json:"annual_rate"`
+	// MinResidualRate は残存価値の下限割合 (0.0〜1.0)
+	MinResidualRate float64 `json:"min_residual_rate"`
+}
+
+// CategoryEntry はカテゴリ辞書の1エントリを表すエンティティ
+// アイテムの category はここに登録された Code を参照する
+type CategoryEntry struct {
+	Code               string             `json:"code"`
+	Label              string             `json:"label"`
+	Sort               int                `json:"sort"`
+	IsVisible          bool               `json:"is_visible"`
+	DepreciationPolicy DepreciationPolicy `json:"depreciation_policy"`
+	CreatedAt          time.Time          `json:"created_at"`
+	UpdatedAt          time.Time          `json:"updated_at"`
+}
+
+// NewCategoryEntry は入力値を検証したうえで新しい CategoryEntry を生成する
+func NewCategoryEntry(code, label string, sort int, isVisible bool, policy DepreciationPolicy) (*CategoryEntry, error) {
+	if len(code) == 0 || len(code) > 50 {
+		return nil, fmt.Errorf("code must be between 1 and 50 characters")
+	}
+
+	if len(label) == 0 || len(label) > 100 {
+		return nil, fmt.Errorf("label must be between 1 and 100 characters")
+	}
+
+	if sort < 0 {
+		return nil, fmt.Errorf("sort must be 0 or greater")
+	}
+
+	if policy.AnnualRate < 0 || policy.AnnualRate > 1 {
+		return nil, fmt.Errorf("depreciation_policy.annual_rate must be between 0 and 1")
+	}
+
+	if policy.MinResidualRate < 0 || policy.MinResidualRate > 1 {
+		return nil, fmt.Errorf("depreciation_policy.min_residual_rate must be between 0 and 1")
+	}
+
+	return &CategoryEntry{
+		Code:               code,
+		Label:              label,
+		Sort:               sort,
+		IsVisible:          isVisible,
+		DepreciationPolicy: policy,
+	}, nil
+}
+
+// CurrentValue は purchasePrice と purchaseDate (YYYY-MM-DD) から定額法による現在価値を算出する
+func (p DepreciationPolicy) CurrentValue(purchasePrice int, purchaseDate string, now time.Time) int {
+	purchasedAt, err := time.Parse("2006-01-02", purchaseDate)
+	if err != nil {
+		return purchasePrice
+	}
+
+	years := now.Sub(purchasedAt).Hours() / 24 / 365.25
+	if years < 0 {
+		years = 0
+	}
+
+	residualRate := 1 - p.AnnualRate*years
+	if residualRate < p.MinResidualRate {
+		residualRate = p.MinResidualRate
+	}
+
+	return int(float64(purchasePrice) * residualRate)
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDepreciationPolicy_CurrentValue(t *testing.T) {
+	policy := DepreciationPolicy{AnnualRate: 0.2, MinResidualRate: 0.1}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		purchasePrice int
+		purchaseDate  string
+		want          int
+	}{
+		{"just purchased", 10000, "2026-01-01", 10000},
+		{"two years elapsed, within residual floor", 10000, "2024-01-01", 5997},
+		{"clamped to minimum residual", 10000, "2010-01-01", 1000},
+		{"invalid date falls back to purchase price", 10000, "not-a-date", 10000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := policy.CurrentValue(tt.purchasePrice, tt.purchaseDate, now)
+			if got != tt.want {
+				t.Errorf("CurrentValue() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Item はコレクターが管理する所持品1件を表すエンティティ
+type Item struct {
+	ID            int64     `json:"id"`
+	Name          string    `json:"name"`
+	Category      string    `json:"category"`
+	Brand         string    `json:"brand"`
+	PurchasePrice int       `json:"purchase_price"`
+	PurchaseDate  string    `json:"purchase_date"`
+	// Version は楽観的排他制御のための更新バージョン。更新の度にインクリメントされる
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// DeletedAt が設定されている場合、このアイテムはソフトデリート済みであることを示す
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// IsDeleted はアイテムがソフトデリートされているかどうかを返す
+func (i *Item) IsDeleted() bool {
+	return i.DeletedAt != nil
+}
+
+// NewItem は入力値を検証したうえで新しい Item を生成する
+// category が辞書に登録された有効なコードであるかどうかは usecase 層 (CategoryDictionaryUsecase) が検証する
+func NewItem(name, category, brand string, purchasePrice int, purchaseDate string) (*Item, error) {
+	if len(name) == 0 || len(name) > 100 {
+		return nil, fmt.Errorf("name must be between 1 and 100 characters")
+	}
+
+	if len(category) == 0 || len(category) > 50 {
+		return nil, fmt.Errorf("category must be between 1 and 50 characters")
+	}
+
+	if len(brand) == 0 || len(brand) > 100 {
+		return nil, fmt.Errorf("brand must be between 1 and 100 characters")
+	}
+
+	if purchasePrice < 0 {
+		return nil, fmt.Errorf("purchase_price must be 0 or greater")
+	}
+
+	if _, err := time.Parse("2006-01-02", purchaseDate); err != nil {
+		return nil, fmt.Errorf("purchase_date must be in YYYY-MM-DD format")
+	}
+
+	return &Item{
+		Name:          name,
+		Category:      category,
+		Brand:         brand,
+		PurchasePrice: purchasePrice,
+		PurchaseDate:  purchaseDate,
+		Version:       1,
+	}, nil
+}
@@ -0,0 +1,20 @@
+package errors
+
+import "errors"
+
+var (
+	// ErrInvalidInput はリクエスト内容がバリデーションに違反している場合に返す
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrItemNotFound は指定されたIDのアイテムが存在しない場合に返す
+	ErrItemNotFound = errors.New("item not found")
+	// ErrNotFound はリポジトリ層で該当レコードが見つからなかったことを示す
+	ErrNotFound = errors.New("not found")
+	// ErrConflict は楽観的排他制御の対象レコードが、送信されたversionから更新されている場合に返す
+	// また、アイテムから参照されているカテゴリ辞書エントリを削除しようとした場合にも返す
+	ErrConflict = errors.New("conflict")
+)
+
+// IsNotFoundError はリポジトリ層から返されたエラーが「未検出」を表すものかどうかを判定する
+func IsNotFoundError(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
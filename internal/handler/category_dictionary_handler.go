@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"Aicon-assignment/internal/usecase"
+)
+
+// CategoryDictionaryHandler は /categories 配下の管理者向けエンドポイントを処理する
+// 呼び出し側のルーターで管理者権限を要求するミドルウェアを被せることを前提とする
+type CategoryDictionaryHandler struct {
+	categoryUsecase usecase.CategoryDictionaryUsecase
+}
+
+func NewCategoryDictionaryHandler(categoryUsecase usecase.CategoryDictionaryUsecase) *CategoryDictionaryHandler {
+	return &CategoryDictionaryHandler{categoryUsecase: categoryUsecase}
+}
+
+// ListCategories は GET /categories を処理する
+func (h *CategoryDictionaryHandler) ListCategories(w http.ResponseWriter, r *http.Request) {
+	categories, err := h.categoryUsecase.ListCategories(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(categories)
+}
+
+// CreateCategory は POST /categories を処理する
+func (h *CategoryDictionaryHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateCategoryInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	category, err := h.categoryUsecase.CreateCategory(r.Context(), input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(category)
+}
+
+// UpdateCategory は PATCH /categories/{code} を処理する
+func (h *CategoryDictionaryHandler) UpdateCategory(w http.ResponseWriter, r *http.Request, code string) {
+	var input usecase.UpdateCategoryInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	category, err := h.categoryUsecase.UpdateCategory(r.Context(), code, input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(category)
+}
+
+// DeleteCategory は DELETE /categories/{code} を処理する
+func (h *CategoryDictionaryHandler) DeleteCategory(w http.ResponseWriter, r *http.Request, code string) {
+	if err := h.categoryUsecase.DeleteCategory(r.Context(), code); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// writeError はusecase層から返されたエラーを適切なHTTPステータスにマッピングする
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domainErrors.ErrItemNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domainErrors.ErrInvalidInput):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domainErrors.ErrConflict):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}
+}
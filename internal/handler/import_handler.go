@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/usecase"
+)
+
+// ImportItems は POST /items/import を処理する
+// ?format=csv|json, ?dry_run=true に加え、CSVの列位置とヘッダ行のスキップ数を
+// ?start_row, ?name_col, ?category_col, ?brand_col, ?purchase_price_col, ?purchase_date_col で上書きできる
+func (h *ItemHandler) ImportItems(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	format := usecase.ImportFormat(query.Get("format"))
+	if format == "" {
+		format = usecase.ImportFormatCSV
+	}
+
+	opts := usecase.ImportOptions{
+		DryRun:       query.Get("dry_run") == "true",
+		FieldMapping: usecase.DefaultImportFieldMapping(),
+	}
+
+	// CSVはヘッダ行を含むのが通常のため、未指定時は1行目をスキップする
+	opts.StartRow = 1
+	if v := query.Get("start_row"); v != "" {
+		startRow, err := strconv.Atoi(v)
+		if err != nil || startRow < 0 {
+			http.Error(w, "invalid start_row", http.StatusBadRequest)
+			return
+		}
+		opts.StartRow = startRow
+	}
+
+	if !setColumnOverride(w, query, "name_col", &opts.FieldMapping.Name) {
+		return
+	}
+	if !setColumnOverride(w, query, "category_col", &opts.FieldMapping.Category) {
+		return
+	}
+	if !setColumnOverride(w, query, "brand_col", &opts.FieldMapping.Brand) {
+		return
+	}
+	if !setColumnOverride(w, query, "purchase_price_col", &opts.FieldMapping.PurchasePrice) {
+		return
+	}
+	if !setColumnOverride(w, query, "purchase_date_col", &opts.FieldMapping.PurchaseDate) {
+		return
+	}
+
+	result, err := h.itemUsecase.ImportItems(r.Context(), r.Body, format, opts)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// setColumnOverride はクエリパラメータで列インデックスの上書きが指定されていれば反映する
+// 不正な値の場合は 400 を書き込み false を返す
+func setColumnOverride(w http.ResponseWriter, query map[string][]string, key string, dst *int) bool {
+	values, ok := query[key]
+	if !ok || len(values) == 0 || values[0] == "" {
+		return true
+	}
+
+	col, err := strconv.Atoi(values[0])
+	if err != nil || col < 0 {
+		http.Error(w, "invalid "+key, http.StatusBadRequest)
+		return false
+	}
+	*dst = col
+	return true
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/usecase"
+)
+
+// ItemHandler は /items 配下のエンドポイントを処理する
+type ItemHandler struct {
+	itemUsecase usecase.ItemUsecase
+}
+
+func NewItemHandler(itemUsecase usecase.ItemUsecase) *ItemHandler {
+	return &ItemHandler{itemUsecase: itemUsecase}
+}
+
+// ListItems は GET /items を処理し、ページネーション・絞り込み・並び替え条件をクエリパラメータから組み立てる
+func (h *ItemHandler) ListItems(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	input := usecase.ListItemsInput{
+		SortBy:    usecase.SortField(query.Get("sort_by")),
+		SortOrder: usecase.SortOrder(query.Get("sort_order")),
+	}
+
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		input.Limit = limit
+	}
+
+	if v := query.Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "invalid offset", http.StatusBadRequest)
+			return
+		}
+		input.Offset = offset
+	}
+
+	if v := query.Get("category"); v != "" {
+		input.Category = &v
+	}
+	if v := query.Get("brand"); v != "" {
+		input.Brand = &v
+	}
+	if v := query.Get("purchase_price_min"); v != "" {
+		min, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "invalid purchase_price_min", http.StatusBadRequest)
+			return
+		}
+		input.MinPurchasePrice = &min
+	}
+	if v := query.Get("purchase_price_max"); v != "" {
+		max, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "invalid purchase_price_max", http.StatusBadRequest)
+			return
+		}
+		input.MaxPurchasePrice = &max
+	}
+	if v := query.Get("purchase_date_from"); v != "" {
+		input.PurchaseDateFrom = &v
+	}
+	if v := query.Get("purchase_date_to"); v != "" {
+		input.PurchaseDateTo = &v
+	}
+	if query.Get("include_deleted") == "true" {
+		input.IncludeDeleted = true
+	}
+
+	result, err := h.itemUsecase.GetAllItems(r.Context(), input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"Aicon-assignment/internal/usecase"
+)
+
+// ListDeletedItems は GET /items/trash を処理する
+func (h *ItemHandler) ListDeletedItems(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	input := usecase.ListItemsInput{}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		input.Limit = limit
+	}
+	if v := query.Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "invalid offset", http.StatusBadRequest)
+			return
+		}
+		input.Offset = offset
+	}
+
+	result, err := h.itemUsecase.ListDeletedItems(r.Context(), input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// RestoreItem は POST /items/{id}/restore を処理する
+func (h *ItemHandler) RestoreItem(w http.ResponseWriter, r *http.Request, id int64) {
+	item, err := h.itemUsecase.RestoreItem(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(item)
+}
+
+// PurgeItem は DELETE /items/{id}/purge を処理する
+func (h *ItemHandler) PurgeItem(w http.ResponseWriter, r *http.Request, id int64) {
+	if err := h.itemUsecase.PurgeItem(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"Aicon-assignment/internal/usecase"
+)
+
+// GetItemValuation は GET /items/{id}/valuation を処理する
+func (h *ItemHandler) GetItemValuation(w http.ResponseWriter, r *http.Request, id int64) {
+	valuation, err := h.itemUsecase.GetItemValuation(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(valuation)
+}
+
+// GetCategorySummary は GET /items/summary を処理する
+// ?include_deleted=true でソフトデリート済みのアイテムも集計に含める
+func (h *ItemHandler) GetCategorySummary(w http.ResponseWriter, r *http.Request) {
+	opts := usecase.GetItemOptions{
+		IncludeDeleted: r.URL.Query().Get("include_deleted") == "true",
+	}
+
+	summary, err := h.itemUsecase.GetCategorySummary(r.Context(), opts)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(summary)
+}
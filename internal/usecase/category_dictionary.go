@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// CreateCategoryInput は POST /categories のリクエストボディ
+type CreateCategoryInput struct {
+	Code               string                    `json:"code"`
+	Label              string                    `json:"label"`
+	Sort               int                       `json:"sort"`
+	IsVisible          bool                      `json:"is_visible"`
+	DepreciationPolicy entity.DepreciationPolicy `json:"depreciation_policy"`
+}
+
+// UpdateCategoryInput は PATCH /categories/{code} のリクエストボディ
+type UpdateCategoryInput struct {
+	Label              *string                   `json:"label,omitempty"`
+	Sort               *int                      `json:"sort,omitempty"`
+	IsVisible          *bool                     `json:"is_visible,omitempty"`
+	DepreciationPolicy *entity.DepreciationPolicy `json:"depreciation_policy,omitempty"`
+}
+
+// CategoryDictionaryUsecase はカテゴリ辞書の管理とアイテムのカテゴリ検証を提供する
+type CategoryDictionaryUsecase interface {
+	ListCategories(ctx context.Context) ([]*entity.CategoryEntry, error)
+	// GetCategory は指定したコードの辞書エントリを返す (公開状態に関わらず取得できる)
+	GetCategory(ctx context.Context, code string) (*entity.CategoryEntry, error)
+	CreateCategory(ctx context.Context, input CreateCategoryInput) (*entity.CategoryEntry, error)
+	UpdateCategory(ctx context.Context, code string, input UpdateCategoryInput) (*entity.CategoryEntry, error)
+	DeleteCategory(ctx context.Context, code string) error
+	// ValidateCategory は code が登録済みかつ公開状態のカテゴリであることを検証する
+	ValidateCategory(ctx context.Context, code string) error
+}
+
+type categoryDictionaryUsecase struct {
+	categoryRepo CategoryDictionaryRepository
+	itemRepo     ItemRepository
+}
+
+func NewCategoryDictionaryUsecase(categoryRepo CategoryDictionaryRepository, itemRepo ItemRepository) CategoryDictionaryUsecase {
+	return &categoryDictionaryUsecase{categoryRepo: categoryRepo, itemRepo: itemRepo}
+}
+
+func (u *categoryDictionaryUsecase) ListCategories(ctx context.Context) ([]*entity.CategoryEntry, error) {
+	entries, err := u.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve categories: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (u *categoryDictionaryUsecase) GetCategory(ctx context.Context, code string) (*entity.CategoryEntry, error) {
+	entry, err := u.categoryRepo.FindByCode(ctx, code)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, fmt.Errorf("%w: category %q not found", domainErrors.ErrInvalidInput, code)
+		}
+		return nil, fmt.Errorf("failed to retrieve category: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (u *categoryDictionaryUsecase) CreateCategory(ctx context.Context, input CreateCategoryInput) (*entity.CategoryEntry, error) {
+	entry, err := entity.NewCategoryEntry(input.Code, input.Label, input.Sort, input.IsVisible, input.DepreciationPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domainErrors.ErrInvalidInput, err.Error())
+	}
+
+	if _, err := u.categoryRepo.FindByCode(ctx, entry.Code); err == nil {
+		return nil, fmt.Errorf("%w: category %q already exists", domainErrors.ErrInvalidInput, entry.Code)
+	} else if !domainErrors.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to check existing category: %w", err)
+	}
+
+	createdEntry, err := u.categoryRepo.Create(ctx, entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return createdEntry, nil
+}
+
+func (u *categoryDictionaryUsecase) UpdateCategory(ctx context.Context, code string, input UpdateCategoryInput) (*entity.CategoryEntry, error) {
+	existingEntry, err := u.categoryRepo.FindByCode(ctx, code)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, fmt.Errorf("%w: category %q not found", domainErrors.ErrInvalidInput, code)
+		}
+		return nil, fmt.Errorf("failed to retrieve category for update: %w", err)
+	}
+
+	if input.Label != nil {
+		if len(*input.Label) == 0 || len(*input.Label) > 100 {
+			return nil, fmt.Errorf("%w: label must be between 1 and 100 characters", domainErrors.ErrInvalidInput)
+		}
+		existingEntry.Label = *input.Label
+	}
+
+	if input.Sort != nil {
+		if *input.Sort < 0 {
+			return nil, fmt.Errorf("%w: sort must be 0 or greater", domainErrors.ErrInvalidInput)
+		}
+		existingEntry.Sort = *input.Sort
+	}
+
+	if input.DepreciationPolicy != nil {
+		if input.DepreciationPolicy.AnnualRate < 0 || input.DepreciationPolicy.AnnualRate > 1 {
+			return nil, fmt.Errorf("%w: depreciation_policy.annual_rate must be between 0 and 1", domainErrors.ErrInvalidInput)
+		}
+		if input.DepreciationPolicy.MinResidualRate < 0 || input.DepreciationPolicy.MinResidualRate > 1 {
+			return nil, fmt.Errorf("%w: depreciation_policy.min_residual_rate must be between 0 and 1", domainErrors.ErrInvalidInput)
+		}
+		existingEntry.DepreciationPolicy = *input.DepreciationPolicy
+	}
+
+	if input.IsVisible != nil {
+		existingEntry.IsVisible = *input.IsVisible
+	}
+
+	updatedEntry, err := u.categoryRepo.Update(ctx, existingEntry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return updatedEntry, nil
+}
+
+// DeleteCategory はカテゴリ辞書エントリを削除する
+// 既存アイテムが参照しているコードは削除できない (代わりに IsVisible=false で非表示にする)
+func (u *categoryDictionaryUsecase) DeleteCategory(ctx context.Context, code string) error {
+	if _, err := u.categoryRepo.FindByCode(ctx, code); err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return fmt.Errorf("%w: category %q not found", domainErrors.ErrInvalidInput, code)
+		}
+		return fmt.Errorf("failed to check category existence: %w", err)
+	}
+
+	inUse, err := u.itemRepo.ExistsByCategory(ctx, code)
+	if err != nil {
+		return fmt.Errorf("failed to check category usage: %w", err)
+	}
+	if inUse {
+		return fmt.Errorf("%w: category %q is still referenced by existing items", domainErrors.ErrConflict, code)
+	}
+
+	if err := u.categoryRepo.Delete(ctx, code); err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	return nil
+}
+
+func (u *categoryDictionaryUsecase) ValidateCategory(ctx context.Context, code string) error {
+	entry, err := u.categoryRepo.FindByCode(ctx, code)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return fmt.Errorf("%w: category %q does not exist", domainErrors.ErrInvalidInput, code)
+		}
+		return fmt.Errorf("failed to validate category: %w", err)
+	}
+
+	if !entry.IsVisible {
+		return fmt.Errorf("%w: category %q is not available", domainErrors.ErrInvalidInput, code)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// CategoryDictionaryRepository はカテゴリ辞書の永続化を担うリポジトリのインターフェース
+type CategoryDictionaryRepository interface {
+	// FindAll は Sort 昇順でカテゴリ辞書の全エントリを返す
+	FindAll(ctx context.Context) ([]*entity.CategoryEntry, error)
+	FindByCode(ctx context.Context, code string) (*entity.CategoryEntry, error)
+	Create(ctx context.Context, entry *entity.CategoryEntry) (*entity.CategoryEntry, error)
+	Update(ctx context.Context, entry *entity.CategoryEntry) (*entity.CategoryEntry, error)
+	Delete(ctx context.Context, code string) error
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+func TestItemUsecase_GetCategorySummary_Aggregation(t *testing.T) {
+	repo := newFakeItemRepository()
+	seedItem(repo, "bag", 10000, "2026-01-01")
+	seedItem(repo, "watch", 20000, "2026-01-01")
+
+	bagCategory, err := entity.NewCategoryEntry("bag", "Bag", 1, true, entity.DepreciationPolicy{AnnualRate: 0.1, MinResidualRate: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	watchCategory, err := entity.NewCategoryEntry("watch", "Watch", 2, true, entity.DepreciationPolicy{AnnualRate: 0.5, MinResidualRate: 0.2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u := newTestItemUsecase(repo, bagCategory, watchCategory)
+
+	summary, err := u.GetCategorySummary(context.Background(), GetItemOptions{})
+	if err != nil {
+		t.Fatalf("GetCategorySummary failed: %v", err)
+	}
+
+	if summary.TotalCount != 2 {
+		t.Errorf("TotalCount = %d, want 2", summary.TotalCount)
+	}
+	if summary.TotalPurchase != 30000 {
+		t.Errorf("TotalPurchase = %d, want 30000", summary.TotalPurchase)
+	}
+
+	if len(summary.Categories) != 2 {
+		t.Fatalf("expected 2 categories in summary, got %d", len(summary.Categories))
+	}
+	if summary.Categories[0].Code != "bag" || summary.Categories[1].Code != "watch" {
+		t.Errorf("expected categories ordered by Sort (bag, watch), got %q, %q", summary.Categories[0].Code, summary.Categories[1].Code)
+	}
+}
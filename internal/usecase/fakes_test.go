@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"Aicon-assignment/internal/domain/entity"
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+// fakeItemRepository はテスト用のインメモリ ItemRepository 実装
+type fakeItemRepository struct {
+	items  map[int64]*entity.Item
+	nextID int64
+}
+
+func newFakeItemRepository() *fakeItemRepository {
+	return &fakeItemRepository{items: make(map[int64]*entity.Item)}
+}
+
+func (f *fakeItemRepository) FindAll(ctx context.Context, opts ListItemsInput) ([]*entity.Item, int, error) {
+	var result []*entity.Item
+	for _, item := range f.items {
+		if item.IsDeleted() && !opts.IncludeDeleted {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result, len(result), nil
+}
+
+func (f *fakeItemRepository) FindDeleted(ctx context.Context, opts ListItemsInput) ([]*entity.Item, int, error) {
+	var result []*entity.Item
+	for _, item := range f.items {
+		if item.IsDeleted() {
+			result = append(result, item)
+		}
+	}
+	return result, len(result), nil
+}
+
+func (f *fakeItemRepository) FindByID(ctx context.Context, id int64) (*entity.Item, error) {
+	item, ok := f.items[id]
+	if !ok {
+		return nil, domainErrors.ErrNotFound
+	}
+	copied := *item
+	return &copied, nil
+}
+
+func (f *fakeItemRepository) Create(ctx context.Context, item *entity.Item) (*entity.Item, error) {
+	f.nextID++
+	item.ID = f.nextID
+	f.items[item.ID] = item
+	return item, nil
+}
+
+func (f *fakeItemRepository) CreateBatch(ctx context.Context, items []*entity.Item) ([]*entity.Item, error) {
+	for _, item := range items {
+		if _, err := f.Create(ctx, item); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeItemRepository) Update(ctx context.Context, item *entity.Item, expectedVersion int) (*entity.Item, error) {
+	existing, ok := f.items[item.ID]
+	if !ok {
+		return nil, domainErrors.ErrNotFound
+	}
+	if existing.Version != expectedVersion {
+		return nil, domainErrors.ErrConflict
+	}
+	f.items[item.ID] = item
+	return item, nil
+}
+
+func (f *fakeItemRepository) Delete(ctx context.Context, id int64) error {
+	item, ok := f.items[id]
+	if !ok {
+		return domainErrors.ErrNotFound
+	}
+	now := item.UpdatedAt
+	item.DeletedAt = &now
+	return nil
+}
+
+func (f *fakeItemRepository) Restore(ctx context.Context, id int64) (*entity.Item, error) {
+	item, ok := f.items[id]
+	if !ok {
+		return nil, domainErrors.ErrNotFound
+	}
+	item.DeletedAt = nil
+	return item, nil
+}
+
+func (f *fakeItemRepository) Purge(ctx context.Context, id int64) error {
+	if _, ok := f.items[id]; !ok {
+		return domainErrors.ErrNotFound
+	}
+	delete(f.items, id)
+	return nil
+}
+
+func (f *fakeItemRepository) ListForValuation(ctx context.Context, includeDeleted bool) ([]*entity.Item, error) {
+	var result []*entity.Item
+	for _, item := range f.items {
+		if item.IsDeleted() && !includeDeleted {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+func (f *fakeItemRepository) ExistsByCategory(ctx context.Context, category string) (bool, error) {
+	for _, item := range f.items {
+		if item.Category == category {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fakeTransactor はテスト用の Transactor 実装。トランザクションを模倣せず fn をそのまま実行する
+type fakeTransactor struct{}
+
+func (fakeTransactor) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeCategoryDictionaryUsecase はテスト用の CategoryDictionaryUsecase 実装
+type fakeCategoryDictionaryUsecase struct {
+	entries map[string]*entity.CategoryEntry
+}
+
+func newFakeCategoryDictionaryUsecase(entries ...*entity.CategoryEntry) *fakeCategoryDictionaryUsecase {
+	m := make(map[string]*entity.CategoryEntry)
+	for _, e := range entries {
+		m[e.Code] = e
+	}
+	return &fakeCategoryDictionaryUsecase{entries: m}
+}
+
+// ListCategories は実装 (CategoryDictionaryRepository.FindAll) と同じくSort昇順で返す
+func (f *fakeCategoryDictionaryUsecase) ListCategories(ctx context.Context) ([]*entity.CategoryEntry, error) {
+	var result []*entity.CategoryEntry
+	for _, e := range f.entries {
+		result = append(result, e)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Sort < result[j].Sort })
+	return result, nil
+}
+
+func (f *fakeCategoryDictionaryUsecase) GetCategory(ctx context.Context, code string) (*entity.CategoryEntry, error) {
+	entry, ok := f.entries[code]
+	if !ok {
+		return nil, domainErrors.ErrInvalidInput
+	}
+	return entry, nil
+}
+
+func (f *fakeCategoryDictionaryUsecase) CreateCategory(ctx context.Context, input CreateCategoryInput) (*entity.CategoryEntry, error) {
+	return nil, nil
+}
+
+func (f *fakeCategoryDictionaryUsecase) UpdateCategory(ctx context.Context, code string, input UpdateCategoryInput) (*entity.CategoryEntry, error) {
+	return nil, nil
+}
+
+func (f *fakeCategoryDictionaryUsecase) DeleteCategory(ctx context.Context, code string) error {
+	return nil
+}
+
+func (f *fakeCategoryDictionaryUsecase) ValidateCategory(ctx context.Context, code string) error {
+	entry, ok := f.entries[code]
+	if !ok || !entry.IsVisible {
+		return domainErrors.ErrInvalidInput
+	}
+	return nil
+}
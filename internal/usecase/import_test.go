@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+func TestParseCSVRows_StartRowAndFieldMapping(t *testing.T) {
+	// 列順を brand,name,category,purchase_date,purchase_price に入れ替え、ヘッダ行をStartRowでスキップする
+	csv := "brand,name,category,purchase_date,purchase_price\n" +
+		"Rolex,Submariner,watch,2020-01-01,500000\n"
+
+	mapping := ImportFieldMapping{
+		Name:          1,
+		Category:      2,
+		Brand:         0,
+		PurchasePrice: 4,
+		PurchaseDate:  3,
+	}
+
+	rows, rowErrors, err := parseCSVRows(strings.NewReader(csv), ImportOptions{FieldMapping: mapping, StartRow: 1})
+	if err != nil {
+		t.Fatalf("parseCSVRows failed: %v", err)
+	}
+	if len(rowErrors) != 0 {
+		t.Fatalf("expected no row errors, got %v", rowErrors)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(rows))
+	}
+
+	got := rows[0]
+	if got.name != "Submariner" || got.category != "watch" || got.brand != "Rolex" || got.purchasePrice != 500000 || got.purchaseDate != "2020-01-01" {
+		t.Errorf("unexpected row: %+v", got)
+	}
+	if got.row != 2 {
+		t.Errorf("row = %d, want 2 (1-indexed, after header)", got.row)
+	}
+}
+
+func TestParseCSVRows_InvalidPurchasePriceReportedAsRowError(t *testing.T) {
+	csv := "name,category,brand,purchase_price,purchase_date\n" +
+		"bag,bag,Gucci,abc,2020-01-01\n"
+
+	rows, rowErrors, err := parseCSVRows(strings.NewReader(csv), ImportOptions{FieldMapping: DefaultImportFieldMapping(), StartRow: 1})
+	if err != nil {
+		t.Fatalf("parseCSVRows failed: %v", err)
+	}
+	if len(rows) != 0 {
+		t.Fatalf("expected malformed row to be excluded, got %v", rows)
+	}
+	if len(rowErrors) != 1 {
+		t.Fatalf("expected 1 row error, got %d: %v", len(rowErrors), rowErrors)
+	}
+	if rowErrors[0].Field != "purchase_price" {
+		t.Errorf("Field = %q, want purchase_price", rowErrors[0].Field)
+	}
+}
+
+func TestParseJSONRows(t *testing.T) {
+	jsonBody := `[{"name":"bag","category":"bag","brand":"Gucci","purchase_price":10000,"purchase_date":"2020-01-01"}]`
+
+	rows, err := parseJSONRows(strings.NewReader(jsonBody))
+	if err != nil {
+		t.Fatalf("parseJSONRows failed: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(rows))
+	}
+	if rows[0].category != "bag" || rows[0].purchasePrice != 10000 {
+		t.Errorf("unexpected row: %+v", rows[0])
+	}
+}
+
+func TestItemUsecase_ImportItems_RejectsUnknownCategory(t *testing.T) {
+	repo := newFakeItemRepository()
+	bagCategory, err := entity.NewCategoryEntry("bag", "Bag", 1, true, entity.DepreciationPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := newTestItemUsecase(repo, bagCategory)
+
+	csv := "name,category,brand,purchase_price,purchase_date\n" +
+		"Bogus Item,totally-bogus-category,Gucci,10000,2020-01-01\n"
+
+	result, err := u.ImportItems(context.Background(), strings.NewReader(csv), ImportFormatCSV, ImportOptions{FieldMapping: DefaultImportFieldMapping(), StartRow: 1})
+	if err != nil {
+		t.Fatalf("ImportItems failed: %v", err)
+	}
+	if result.Created != 0 {
+		t.Errorf("Created = %d, want 0 for unknown category", result.Created)
+	}
+	if result.Failed != 1 {
+		t.Fatalf("Failed = %d, want 1", result.Failed)
+	}
+	if result.Errors[0].Field != "category" {
+		t.Errorf("Field = %q, want category", result.Errors[0].Field)
+	}
+}
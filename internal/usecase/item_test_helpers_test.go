@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+func newTestItemUsecase(repo *fakeItemRepository, categories ...*entity.CategoryEntry) ItemUsecase {
+	return NewItemUsecase(repo, fakeTransactor{}, newFakeCategoryDictionaryUsecase(categories...))
+}
+
+func seedItem(repo *fakeItemRepository, category string, price int, date string) *entity.Item {
+	item, err := entity.NewItem("name", category, "brand", price, date)
+	if err != nil {
+		panic(err)
+	}
+	created, _ := repo.Create(context.Background(), item)
+	return created
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestListItemsInput_Normalize_Defaults(t *testing.T) {
+	in := ListItemsInput{}
+	if err := in.Normalize(); err != nil {
+		t.Fatalf("Normalize failed: %v", err)
+	}
+	if in.Limit != defaultLimit {
+		t.Errorf("Limit = %d, want defaultLimit %d", in.Limit, defaultLimit)
+	}
+	if in.SortBy != SortByCreatedAt {
+		t.Errorf("SortBy = %q, want %q", in.SortBy, SortByCreatedAt)
+	}
+	if in.SortOrder != SortOrderDesc {
+		t.Errorf("SortOrder = %q, want %q", in.SortOrder, SortOrderDesc)
+	}
+}
+
+func TestListItemsInput_Normalize_ClampsLimit(t *testing.T) {
+	in := ListItemsInput{Limit: maxLimit + 1}
+	if err := in.Normalize(); err != nil {
+		t.Fatalf("Normalize failed: %v", err)
+	}
+	if in.Limit != maxLimit {
+		t.Errorf("Limit = %d, want clamped to maxLimit %d", in.Limit, maxLimit)
+	}
+}
+
+func TestListItemsInput_Normalize_NegativeOffset(t *testing.T) {
+	in := ListItemsInput{Offset: -1}
+	err := in.Normalize()
+	if !errors.Is(err, domainErrors.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for negative offset, got %v", err)
+	}
+}
+
+func TestListItemsInput_Normalize_InvalidSortBy(t *testing.T) {
+	in := ListItemsInput{SortBy: "not-a-field"}
+	err := in.Normalize()
+	if !errors.Is(err, domainErrors.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for invalid sort_by, got %v", err)
+	}
+}
+
+func TestListItemsInput_Normalize_InvalidSortOrder(t *testing.T) {
+	in := ListItemsInput{SortOrder: "sideways"}
+	err := in.Normalize()
+	if !errors.Is(err, domainErrors.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for invalid sort_order, got %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"Aicon-assignment/internal/domain/entity"
+)
+
+// ItemRepository は Item の永続化を担うリポジトリのインターフェース
+type ItemRepository interface {
+	FindAll(ctx context.Context, opts ListItemsInput) ([]*entity.Item, int, error)
+	// FindDeleted はソフトデリート済みのアイテムのみを対象に一覧取得する (ゴミ箱表示用)
+	FindDeleted(ctx context.Context, opts ListItemsInput) ([]*entity.Item, int, error)
+	FindByID(ctx context.Context, id int64) (*entity.Item, error)
+	Create(ctx context.Context, item *entity.Item) (*entity.Item, error)
+	CreateBatch(ctx context.Context, items []*entity.Item) ([]*entity.Item, error)
+	// Update は `UPDATE ... WHERE id=? AND version=?` を実行する。expectedVersion が
+	// 取得時点のversionと一致しない場合は対象行が0件になり、ErrConflict を返す
+	Update(ctx context.Context, item *entity.Item, expectedVersion int) (*entity.Item, error)
+	// Delete は deleted_at を設定するソフトデリートを行う
+	Delete(ctx context.Context, id int64) error
+	// Restore はソフトデリートされたアイテムの deleted_at をクリアする
+	Restore(ctx context.Context, id int64) (*entity.Item, error)
+	// Purge は行を完全に削除するハードデリートを行う
+	Purge(ctx context.Context, id int64) error
+	// ListForValuation はページネーションなしで (ソフトデリート済みを除く、または含む) 全アイテムを返す
+	// カテゴリサマリや評価額計算のような集計処理専用に使う
+	ListForValuation(ctx context.Context, includeDeleted bool) ([]*entity.Item, error)
+	// ExistsByCategory は指定したカテゴリコードを参照しているアイテムが (ソフトデリート済みも含め) 存在するかどうかを返す
+	ExistsByCategory(ctx context.Context, category string) (bool, error)
+}
+
+// Transactor はリポジトリ層のトランザクション境界を抽象化するインターフェース
+// fn の実行中に発生したエラーはロールバックとして扱われる
+type Transactor interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -2,19 +2,124 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"time"
 
 	"Aicon-assignment/internal/domain/entity"
 	domainErrors "Aicon-assignment/internal/domain/errors"
 )
 
 type ItemUsecase interface {
-	GetAllItems(ctx context.Context) ([]*entity.Item, error)
-	GetItemByID(ctx context.Context, id int64) (*entity.Item, error)
+	GetAllItems(ctx context.Context, input ListItemsInput) (*ListItemsResult, error)
+	GetItemByID(ctx context.Context, id int64, opts GetItemOptions) (*entity.Item, error)
 	CreateItem(ctx context.Context, input CreateItemInput) (*entity.Item, error)
 	UpdateItem(ctx context.Context, id int64, input UpdateItemInput) (*entity.Item, error) // <-- この行を追加
 	DeleteItem(ctx context.Context, id int64) error
-	GetCategorySummary(ctx context.Context) (*CategorySummary, error)
+	GetCategorySummary(ctx context.Context, opts GetItemOptions) (*CategorySummary, error)
+	ImportItems(ctx context.Context, reader io.Reader, format ImportFormat, opts ImportOptions) (*ImportResult, error)
+	// ListDeletedItems はソフトデリート済みのアイテム一覧 (ゴミ箱) を返す
+	ListDeletedItems(ctx context.Context, input ListItemsInput) (*ListItemsResult, error)
+	// RestoreItem はソフトデリートされたアイテムを復元する
+	RestoreItem(ctx context.Context, id int64) (*entity.Item, error)
+	// PurgeItem はソフトデリート済みのアイテムを完全に削除する
+	PurgeItem(ctx context.Context, id int64) error
+	// GetItemValuation は単一アイテムの現在評価額を計算して返す
+	GetItemValuation(ctx context.Context, id int64) (*ItemValuation, error)
+}
+
+// SortField は GetAllItems で並び替え可能なフィールド
+type SortField string
+
+const (
+	SortByName          SortField = "name"
+	SortByPurchasePrice SortField = "purchase_price"
+	SortByPurchaseDate  SortField = "purchase_date"
+	SortByCreatedAt     SortField = "created_at"
+)
+
+// SortOrder は昇順/降順の指定
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// ListItemsInput は GetAllItems のページネーション・絞り込み・並び替え条件
+type ListItemsInput struct {
+	Limit  int
+	Offset int
+
+	Category         *string
+	Brand            *string
+	MinPurchasePrice *int
+	MaxPurchasePrice *int
+	PurchaseDateFrom *string
+	PurchaseDateTo   *string
+
+	SortBy    SortField
+	SortOrder SortOrder
+
+	// IncludeDeleted が true の場合、ソフトデリート済みのアイテムも結果に含める
+	IncludeDeleted bool
+}
+
+// GetItemOptions は GetItemByID の取得条件
+type GetItemOptions struct {
+	IncludeDeleted bool
+}
+
+// Normalize はデフォルト値の補完と値の妥当性チェックを行う
+func (in *ListItemsInput) Normalize() error {
+	if in.Limit <= 0 {
+		in.Limit = defaultLimit
+	}
+	if in.Limit > maxLimit {
+		in.Limit = maxLimit
+	}
+	if in.Offset < 0 {
+		return fmt.Errorf("%w: offset must be 0 or greater", domainErrors.ErrInvalidInput)
+	}
+
+	if in.MinPurchasePrice != nil && *in.MinPurchasePrice < 0 {
+		return fmt.Errorf("%w: purchase_price_min must be 0 or greater", domainErrors.ErrInvalidInput)
+	}
+	if in.MaxPurchasePrice != nil && *in.MaxPurchasePrice < 0 {
+		return fmt.Errorf("%w: purchase_price_max must be 0 or greater", domainErrors.ErrInvalidInput)
+	}
+
+	switch in.SortBy {
+	case "":
+		in.SortBy = SortByCreatedAt
+	case SortByName, SortByPurchasePrice, SortByPurchaseDate, SortByCreatedAt:
+	default:
+		return fmt.Errorf("%w: unsupported sort_by %q", domainErrors.ErrInvalidInput, in.SortBy)
+	}
+
+	switch in.SortOrder {
+	case "":
+		in.SortOrder = SortOrderDesc
+	case SortOrderAsc, SortOrderDesc:
+	default:
+		return fmt.Errorf("%w: unsupported sort_order %q", domainErrors.ErrInvalidInput, in.SortOrder)
+	}
+
+	return nil
+}
+
+// ListItemsResult はページネーション情報を含む一覧取得の結果
+type ListItemsResult struct {
+	Items  []*entity.Item `json:"items"`
+	Total  int            `json:"total"`
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
 }
 
 type CreateItemInput struct {
@@ -31,36 +136,71 @@ type UpdateItemInput struct {
 	Name          *string `json:"name,omitempty"`
 	Brand         *string `json:"brand,omitempty"`
 	PurchasePrice *int    `json:"purchase_price,omitempty"`
+	// Version は更新対象が取得時点から変更されていないことを示すために必須
+	Version int `json:"version"`
 }
 
 // --- (既存の CategorySummary, itemUsecase, NewItemUsecase はそのまま) ---
 
+// CategoryValuation はカテゴリ単位での点数・購入額・現在評価額の集計
+// Code/Label はカテゴリ辞書から引き継がれ、スライスの並び順がそのまま Sort 順になる
+type CategoryValuation struct {
+	Code          string `json:"code"`
+	Label         string `json:"label"`
+	Count         int    `json:"count"`
+	TotalPurchase int    `json:"total_purchase"`
+	TotalCurrent  int    `json:"total_current"`
+}
+
 type CategorySummary struct {
-	Categories map[string]int `json:"categories"`
-	Total      int            `json:"total"`
+	// Categories はカテゴリ辞書の Sort 昇順を保持するスライス (mapは順序を保証しないため使用しない)
+	Categories    []CategoryValuation `json:"categories"`
+	TotalCount    int                 `json:"total_count"`
+	TotalPurchase int                 `json:"total_purchase"`
+	TotalCurrent  int                 `json:"total_current"`
+}
+
+// ItemValuation は単一アイテムの現在評価額
+type ItemValuation struct {
+	ItemID        int64 `json:"item_id"`
+	PurchasePrice int   `json:"purchase_price"`
+	CurrentValue  int   `json:"current_value"`
 }
 
 type itemUsecase struct {
-	itemRepo ItemRepository
+	itemRepo        ItemRepository
+	transactor      Transactor
+	categoryUsecase CategoryDictionaryUsecase
 }
 
-func NewItemUsecase(itemRepo ItemRepository) ItemUsecase {
+func NewItemUsecase(itemRepo ItemRepository, transactor Transactor, categoryUsecase CategoryDictionaryUsecase) ItemUsecase {
 	return &itemUsecase{
-		itemRepo: itemRepo,
+		itemRepo:        itemRepo,
+		transactor:      transactor,
+		categoryUsecase: categoryUsecase,
 	}
 }
 
 // --- (既存の GetAllItems, GetItemByID, CreateItem はそのまま) ---
-func (u *itemUsecase) GetAllItems(ctx context.Context) ([]*entity.Item, error) {
-	items, err := u.itemRepo.FindAll(ctx)
+func (u *itemUsecase) GetAllItems(ctx context.Context, input ListItemsInput) (*ListItemsResult, error) {
+	if err := input.Normalize(); err != nil {
+		return nil, err
+	}
+
+	items, total, err := u.itemRepo.FindAll(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve items: %w", err)
 	}
 
-	return items, nil
+	return &ListItemsResult{
+		Items:  items,
+		Total:  total,
+		Limit:  input.Limit,
+		Offset: input.Offset,
+	}, nil
 }
 
-func (u *itemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item, error) {
+func (u *itemUsecase) GetItemByID(ctx context.Context, id int64, opts GetItemOptions) (*entity.Item, error) {
 	if id <= 0 {
 		return nil, domainErrors.ErrInvalidInput
 	}
@@ -73,10 +213,19 @@ func (u *itemUsecase) GetItemByID(ctx context.Context, id int64) (*entity.Item,
 		return nil, fmt.Errorf("failed to retrieve item: %w", err)
 	}
 
+	if item.IsDeleted() && !opts.IncludeDeleted {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
 	return item, nil
 }
 
 func (u *itemUsecase) CreateItem(ctx context.Context, input CreateItemInput) (*entity.Item, error) {
+	// カテゴリ辞書に登録・公開されているコードかどうかを検証
+	if err := u.categoryUsecase.ValidateCategory(ctx, input.Category); err != nil {
+		return nil, err
+	}
+
 	// バリデーションして、新しいエンティティを作成
 	item, err := entity.NewItem(
 		input.Name,
@@ -112,7 +261,16 @@ func (u *itemUsecase) UpdateItem(ctx context.Context, id int64, input UpdateItem
 		return nil, fmt.Errorf("failed to retrieve item for update: %w", err)
 	}
 
-	// 2. リクエストボディで指定されたフィールドのみバリデーション＆更新
+	if existingItem.IsDeleted() {
+		return nil, domainErrors.ErrItemNotFound
+	}
+
+	// 2. クライアントが取得した時点のversionと一致するか確認する (楽観的排他制御)
+	if input.Version != existingItem.Version {
+		return nil, domainErrors.ErrConflict
+	}
+
+	// 3. リクエストボディで指定されたフィールドのみバリデーション＆更新
 	// (entity.NewItem にあるバリデーションルールを参考に、部分的に適用)
 	if input.Name != nil {
 		if len(*input.Name) == 0 || len(*input.Name) > 100 {
@@ -135,29 +293,38 @@ func (u *itemUsecase) UpdateItem(ctx context.Context, id int64, input UpdateItem
 		existingItem.PurchasePrice = *input.PurchasePrice
 	}
 
-	// 3. データベースを更新
-	// (updated_atはDB側で自動更新される想定)
-	updatedItem, err := u.itemRepo.Update(ctx, existingItem)
+	// 4. データベースを更新
+	// Update は `UPDATE ... WHERE id=? AND version=?` を実行し、対象行が0件だった場合に
+	// ErrConflict を返す (他のクライアントが先に更新した場合など)
+	expectedVersion := existingItem.Version
+	existingItem.Version++
+	updatedItem, err := u.itemRepo.Update(ctx, existingItem, expectedVersion)
 	if err != nil {
+		if errors.Is(err, domainErrors.ErrConflict) {
+			return nil, domainErrors.ErrConflict
+		}
 		return nil, fmt.Errorf("failed to update item: %w", err)
 	}
 
 	return updatedItem, nil
 }
 
-// --- (既存の DeleteItem, GetCategorySummary はそのまま) ---
+// DeleteItem はアイテムをソフトデリートする (deleted_at を設定するのみで行は残る)
 func (u *itemUsecase) DeleteItem(ctx context.Context, id int64) error {
 	if id <= 0 {
 		return domainErrors.ErrInvalidInput
 	}
 
-	_, err := u.itemRepo.FindByID(ctx, id)
+	existingItem, err := u.itemRepo.FindByID(ctx, id)
 	if err != nil {
 		if domainErrors.IsNotFoundError(err) {
 			return domainErrors.ErrItemNotFound
 		}
 		return fmt.Errorf("failed to check item existence: %w", err)
 	}
+	if existingItem.IsDeleted() {
+		return domainErrors.ErrItemNotFound
+	}
 
 	err = u.itemRepo.Delete(ctx, id)
 	if err != nil {
@@ -167,29 +334,146 @@ func (u *itemUsecase) DeleteItem(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (u *itemUsecase) GetCategorySummary(ctx context.Context) (*CategorySummary, error) {
-	categoryCounts, err := u.itemRepo.GetSummaryByCategory(ctx)
+// ListDeletedItems はソフトデリート済みのアイテム一覧 (ゴミ箱) を返す
+func (u *itemUsecase) ListDeletedItems(ctx context.Context, input ListItemsInput) (*ListItemsResult, error) {
+	if err := input.Normalize(); err != nil {
+		return nil, err
+	}
+
+	items, total, err := u.itemRepo.FindDeleted(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve deleted items: %w", err)
+	}
+
+	return &ListItemsResult{
+		Items:  items,
+		Total:  total,
+		Limit:  input.Limit,
+		Offset: input.Offset,
+	}, nil
+}
+
+// RestoreItem はソフトデリートされたアイテムを復元する
+func (u *itemUsecase) RestoreItem(ctx context.Context, id int64) (*entity.Item, error) {
+	if id <= 0 {
+		return nil, domainErrors.ErrInvalidInput
+	}
+
+	existingItem, err := u.itemRepo.FindByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return nil, domainErrors.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to check item existence: %w", err)
+	}
+	if !existingItem.IsDeleted() {
+		return nil, fmt.Errorf("%w: item is not deleted", domainErrors.ErrInvalidInput)
+	}
+
+	restoredItem, err := u.itemRepo.Restore(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore item: %w", err)
+	}
+
+	return restoredItem, nil
+}
+
+// PurgeItem はソフトデリート済みのアイテムを完全に削除する
+func (u *itemUsecase) PurgeItem(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return domainErrors.ErrInvalidInput
+	}
+
+	existingItem, err := u.itemRepo.FindByID(ctx, id)
+	if err != nil {
+		if domainErrors.IsNotFoundError(err) {
+			return domainErrors.ErrItemNotFound
+		}
+		return fmt.Errorf("failed to check item existence: %w", err)
+	}
+	if !existingItem.IsDeleted() {
+		return fmt.Errorf("%w: item must be deleted before it can be purged", domainErrors.ErrInvalidInput)
+	}
+
+	if err := u.itemRepo.Purge(ctx, id); err != nil {
+		return fmt.Errorf("failed to purge item: %w", err)
+	}
+
+	return nil
+}
+
+// GetCategorySummary はカテゴリ辞書をSort順に列挙し、各カテゴリの点数・購入額・
+// 現在評価額 (DepreciationPolicyによる定額法償却を反映) を集計する
+// opts.IncludeDeleted が true の場合、ソフトデリート済みのアイテムも集計に含める
+func (u *itemUsecase) GetCategorySummary(ctx context.Context, opts GetItemOptions) (*CategorySummary, error) {
+	items, err := u.itemRepo.ListForValuation(ctx, opts.IncludeDeleted)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get category summary: %w", err)
 	}
 
-	// 合計計算
-	total := 0
-	for _, count := range categoryCounts {
-		total += count
+	categories, err := u.categoryUsecase.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category summary: %w", err)
 	}
 
-	summary := make(map[string]int)
-	for _, category := range entity.GetValidCategories() {
-		if count, exists := categoryCounts[category]; exists {
-			summary[category] = count
-		} else {
-			summary[category] = 0
+	// categories は既に Sort 昇順で返ってくるので、そのまま並び順を保って集計する
+	policyByCategory := make(map[string]entity.DepreciationPolicy, len(categories))
+	indexByCategory := make(map[string]int, len(categories))
+	result := &CategorySummary{}
+	for _, category := range categories {
+		if !category.IsVisible {
+			continue
 		}
+		policyByCategory[category.Code] = category.DepreciationPolicy
+		indexByCategory[category.Code] = len(result.Categories)
+		result.Categories = append(result.Categories, CategoryValuation{
+			Code:  category.Code,
+			Label: category.Label,
+		})
 	}
 
-	return &CategorySummary{
-		Categories: summary,
-		Total:      total,
+	now := time.Now()
+
+	for _, item := range items {
+		idx, exists := indexByCategory[item.Category]
+		if !exists {
+			continue
+		}
+		valuation := result.Categories[idx]
+
+		policy := policyByCategory[item.Category]
+		currentValue := policy.CurrentValue(item.PurchasePrice, item.PurchaseDate, now)
+
+		valuation.Count++
+		valuation.TotalPurchase += item.PurchasePrice
+		valuation.TotalCurrent += currentValue
+		result.Categories[idx] = valuation
+
+		result.TotalCount++
+		result.TotalPurchase += item.PurchasePrice
+		result.TotalCurrent += currentValue
+	}
+
+	return result, nil
+}
+
+// GetItemValuation は単一アイテムの現在評価額を、所属カテゴリのDepreciationPolicyに基づき算出する
+func (u *itemUsecase) GetItemValuation(ctx context.Context, id int64) (*ItemValuation, error) {
+	item, err := u.GetItemByID(ctx, id, GetItemOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := u.categoryUsecase.GetCategory(ctx, item.Category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item valuation: %w", err)
+	}
+
+	currentValue := category.DepreciationPolicy.CurrentValue(item.PurchasePrice, item.PurchaseDate, time.Now())
+
+	return &ItemValuation{
+		ItemID:        item.ID,
+		PurchasePrice: item.PurchasePrice,
+		CurrentValue:  currentValue,
 	}, nil
 }
\ No newline at end of file
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestItemUsecase_DeleteRestorePurge(t *testing.T) {
+	repo := newFakeItemRepository()
+	item := seedItem(repo, "bag", 1000, "2020-01-01")
+	u := newTestItemUsecase(repo)
+	ctx := context.Background()
+
+	if err := u.DeleteItem(ctx, item.ID); err != nil {
+		t.Fatalf("DeleteItem failed: %v", err)
+	}
+
+	if _, err := u.GetItemByID(ctx, item.ID, GetItemOptions{}); !errors.Is(err, domainErrors.ErrItemNotFound) {
+		t.Fatalf("expected deleted item to be hidden by default, got %v", err)
+	}
+
+	if _, err := u.GetItemByID(ctx, item.ID, GetItemOptions{IncludeDeleted: true}); err != nil {
+		t.Fatalf("expected deleted item to be visible with IncludeDeleted, got %v", err)
+	}
+
+	restored, err := u.RestoreItem(ctx, item.ID)
+	if err != nil {
+		t.Fatalf("RestoreItem failed: %v", err)
+	}
+	if restored.IsDeleted() {
+		t.Fatalf("expected restored item to no longer be deleted")
+	}
+
+	if err := u.PurgeItem(ctx, item.ID); !errors.Is(err, domainErrors.ErrInvalidInput) {
+		t.Fatalf("expected PurgeItem on a non-deleted item to fail with ErrInvalidInput, got %v", err)
+	}
+
+	if err := u.DeleteItem(ctx, item.ID); err != nil {
+		t.Fatalf("DeleteItem failed: %v", err)
+	}
+	if err := u.PurgeItem(ctx, item.ID); err != nil {
+		t.Fatalf("PurgeItem failed: %v", err)
+	}
+	if _, err := u.GetItemByID(ctx, item.ID, GetItemOptions{IncludeDeleted: true}); !errors.Is(err, domainErrors.ErrItemNotFound) {
+		t.Fatalf("expected purged item to be gone entirely, got %v", err)
+	}
+}
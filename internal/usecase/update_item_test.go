@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "Aicon-assignment/internal/domain/errors"
+)
+
+func TestItemUsecase_UpdateItem_VersionConflict(t *testing.T) {
+	repo := newFakeItemRepository()
+	item := seedItem(repo, "bag", 1000, "2020-01-01")
+	u := newTestItemUsecase(repo)
+
+	staleVersion := item.Version
+
+	// 先に一度更新してversionを進めておく
+	newName := "updated once"
+	if _, err := u.UpdateItem(context.Background(), item.ID, UpdateItemInput{Name: &newName, Version: staleVersion}); err != nil {
+		t.Fatalf("first update failed: %v", err)
+	}
+
+	// 古いversionのまま再度更新しようとするとErrConflictになる
+	otherName := "updated twice"
+	_, err := u.UpdateItem(context.Background(), item.ID, UpdateItemInput{Name: &otherName, Version: staleVersion})
+	if !errors.Is(err, domainErrors.ErrConflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+}
+
+func TestItemUsecase_UpdateItem_RejectsDeletedItem(t *testing.T) {
+	repo := newFakeItemRepository()
+	item := seedItem(repo, "bag", 1000, "2020-01-01")
+	u := newTestItemUsecase(repo)
+	ctx := context.Background()
+
+	if err := u.DeleteItem(ctx, item.ID); err != nil {
+		t.Fatalf("DeleteItem failed: %v", err)
+	}
+
+	newName := "should not apply"
+	_, err := u.UpdateItem(ctx, item.ID, UpdateItemInput{Name: &newName, Version: item.Version})
+	if !errors.Is(err, domainErrors.ErrItemNotFound) {
+		t.Fatalf("expected ErrItemNotFound for update on deleted item, got %v", err)
+	}
+}